@@ -0,0 +1,26 @@
+package fail
+
+// H is a generic string-keyed map, used for Params.
+type H map[string]interface{}
+
+// Annotator configures an *Error. Wrap, Base and any other constructor
+// that accepts a variadic ...Annotator apply each one in order.
+type Annotator func(*Error)
+
+// WithCode returns an Annotator that sets Code.
+func WithCode(code interface{}) Annotator {
+	return func(e *Error) {
+		e.Code = code
+	}
+}
+
+// WithMessage returns an Annotator that adds message as the newest entry
+// in Messages, ahead of anything added by an earlier Wrap call.
+func WithMessage(message string) Annotator {
+	return func(e *Error) {
+		if message == "" {
+			return
+		}
+		e.Messages = append([]string{message}, e.Messages...)
+	}
+}
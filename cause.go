@@ -0,0 +1,34 @@
+package fail
+
+import "errors"
+
+// Cause returns e.Err, implementing the causer interface that pkg/errors
+// and its ecosystem (friendsofgo/errors, various gRPC status helpers) use
+// to walk down to a root error.
+func (e *Error) Cause() error {
+	return e.Err
+}
+
+// Cause walks err's chain, preferring causer's Cause() where available and
+// falling back to the Go 1.13 Unwrap chain otherwise, and returns the
+// deepest error it can reach. This lets code doing
+// `switch err := errors.Cause(err).(type)` see through a *fail.Error the
+// same way it already sees through a pkg/errors wrapper.
+func Cause(err error) error {
+	for {
+		if causer, ok := err.(interface{ Cause() error }); ok {
+			if next := causer.Cause(); next != nil {
+				err = next
+				continue
+			}
+			return err
+		}
+
+		if next := errors.Unwrap(err); next != nil {
+			err = next
+			continue
+		}
+
+		return err
+	}
+}
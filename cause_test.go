@@ -0,0 +1,56 @@
+package fail
+
+import (
+	"errors"
+	"testing"
+)
+
+type causer struct {
+	msg   string
+	cause error
+}
+
+func (e *causer) Error() string { return e.msg }
+func (e *causer) Cause() error  { return e.cause }
+
+func TestCause(t *testing.T) {
+	t.Run("walks a *fail.Error down to its wrapped Err", func(t *testing.T) {
+		root := errors.New("root")
+
+		if got := Cause(Wrap(root)); got != root {
+			t.Fatalf("expected Cause to reach %v, got %v", root, got)
+		}
+	})
+
+	t.Run("walks a plain pkg/errors-style causer chain", func(t *testing.T) {
+		root := errors.New("root")
+
+		if got := Cause(&causer{msg: "wrapped", cause: root}); got != root {
+			t.Fatalf("expected Cause to reach %v, got %v", root, got)
+		}
+	})
+
+	t.Run("walks through a *fail.Error wrapping a causer", func(t *testing.T) {
+		root := errors.New("root")
+
+		if got := Cause(Wrap(&causer{msg: "wrapped", cause: root})); got != root {
+			t.Fatalf("expected Cause to reach %v, got %v", root, got)
+		}
+	})
+
+	t.Run("JoinError.Cause returns its own Err", func(t *testing.T) {
+		joined := Join(errors.New("a"), errors.New("b")).(*JoinError)
+
+		if joined.Cause() == nil {
+			t.Fatal("expected JoinError.Cause to return its own Err, not nil")
+		}
+	})
+
+	t.Run("package Cause reaches a JoinError's own Err", func(t *testing.T) {
+		joined := Join(errors.New("a"), errors.New("b")).(*JoinError)
+
+		if got := Cause(joined); got.Error() != joined.Cause().Error() {
+			t.Fatalf("expected Cause(joined) to match joined.Cause(), got %v", got)
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package fail
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestError_Is(t *testing.T) {
+	sentinelA := &Error{Err: errors.New("a"), Code: 400}
+	sentinelB := &Error{Err: errors.New("b"), Code: 400}
+
+	t.Run("matches itself", func(t *testing.T) {
+		if !errors.Is(sentinelA, sentinelA) {
+			t.Fatal("expected errors.Is to match an error against itself")
+		}
+	})
+
+	t.Run("matches through identity of Err", func(t *testing.T) {
+		wrapped := &Error{Err: sentinelA}
+		if !errors.Is(wrapped, sentinelA) {
+			t.Fatal("expected errors.Is to match through Unwrap to the sentinel")
+		}
+	})
+
+	t.Run("does not match on shared Code alone", func(t *testing.T) {
+		if errors.Is(sentinelA, sentinelB) {
+			t.Fatal("errors.Is must not treat two unrelated errors with the same Code as equal")
+		}
+	})
+
+	t.Run("does not panic on incomparable Err", func(t *testing.T) {
+		withSlice := &Error{Err: sliceError{[]int{1, 2, 3}}}
+		other := &Error{Err: errors.New("other")}
+
+		if errors.Is(withSlice, other) {
+			t.Fatal("expected no match")
+		}
+	})
+}
+
+func TestError_As(t *testing.T) {
+	appErr := &Error{Err: errors.New("boom")}
+
+	var target *Error
+	if !errors.As(appErr, &target) {
+		t.Fatal("expected errors.As to capture *Error")
+	}
+	if target != appErr {
+		t.Fatalf("expected target to be appErr, got %v", target)
+	}
+}
+
+type sliceError struct {
+	v []int
+}
+
+func (e sliceError) Error() string { return "slice error" }
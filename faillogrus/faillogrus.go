@@ -0,0 +1,14 @@
+// Package faillogrus adapts *fail.Error to logrus.Fields, kept as a
+// sub-package so the root fail package stays free of a logrus dependency.
+package faillogrus
+
+import (
+	"github.com/izumin5210/rrr"
+	"github.com/sirupsen/logrus"
+)
+
+// ToLogrusFields flattens err via fail.ToMap and returns it as logrus.Fields,
+// ready to pass to logrus's WithFields.
+func ToLogrusFields(err error, opts ...fail.MapOption) logrus.Fields {
+	return logrus.Fields(fail.ToMap(err, opts...))
+}
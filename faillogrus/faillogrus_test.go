@@ -0,0 +1,19 @@
+package faillogrus
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/izumin5210/rrr"
+)
+
+func TestToLogrusFields(t *testing.T) {
+	err := fail.Wrap(errors.New("boom"), func(e *fail.Error) {
+		e.Code = 404
+	})
+
+	fields := ToLogrusFields(err)
+	if fields["code"] != 404 {
+		t.Fatalf("expected code 404, got %v", fields["code"])
+	}
+}
@@ -0,0 +1,19 @@
+// Package failzap adapts *fail.Error to zap.Field, kept as a sub-package so
+// the root fail package stays free of a zap dependency.
+package failzap
+
+import (
+	"github.com/izumin5210/rrr"
+	"go.uber.org/zap"
+)
+
+// ToZapFields flattens err via fail.ToMap and returns it as []zap.Field,
+// ready to pass to a zap logger's structured logging methods.
+func ToZapFields(err error, opts ...fail.MapOption) []zap.Field {
+	m := fail.ToMap(err, opts...)
+	fields := make([]zap.Field, 0, len(m))
+	for k, v := range m {
+		fields = append(fields, zap.Any(k, v))
+	}
+	return fields
+}
@@ -0,0 +1,26 @@
+package failzap
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/izumin5210/rrr"
+)
+
+func TestToZapFields(t *testing.T) {
+	err := fail.Wrap(errors.New("boom"), func(e *fail.Error) {
+		e.Code = 404
+	})
+
+	fields := ToZapFields(err)
+
+	var sawCode bool
+	for _, f := range fields {
+		if f.Key == "code" {
+			sawCode = true
+		}
+	}
+	if !sawCode {
+		t.Fatal("expected a \"code\" field among the zap fields")
+	}
+}
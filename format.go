@@ -0,0 +1,84 @@
+package fail
+
+import (
+	"fmt"
+	"io"
+)
+
+// Format implements fmt.Formatter, following the convention established by
+// pkg/errors and go-ap/errors: %s and %v print FullMessage() (falling back
+// to the wrapped error's message), %q prints that quoted, and %+v appends
+// the code, tags, params and a full stack trace, one frame per line.
+func (e *Error) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('+') {
+			io.WriteString(s, e.verboseMessage())
+			return
+		}
+		io.WriteString(s, e.message())
+	case 's':
+		io.WriteString(s, e.message())
+	case 'q':
+		fmt.Fprintf(s, "%q", e.message())
+	}
+}
+
+func (e *Error) message() string {
+	if message := e.FullMessage(); message != "" {
+		return message
+	}
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	return ""
+}
+
+func (e *Error) verboseMessage() string {
+	msg := e.message()
+	msg += fmt.Sprintf("\ncode=%v", e.Code)
+	msg += fmt.Sprintf("\ntags=%v", e.Tags)
+	msg += fmt.Sprintf("\nparams=%v", e.Params)
+
+	for _, frame := range e.StackTrace {
+		msg += fmt.Sprintf("\n%s\n\t%s:%d", frame.Function, frame.File, frame.Line)
+	}
+
+	// Skip recursing when e.Err is itself a *fail.Error: that's the shape
+	// Wrap(Base(...)) produces, and its Code/Tags/Params were already
+	// copied onto e above, so recursing would just print them twice.
+	if _, isAppErr := e.Err.(*Error); !isAppErr {
+		if _, ok := e.Err.(fmt.Formatter); ok {
+			msg += fmt.Sprintf("\n%+v", e.Err)
+		}
+	}
+
+	return msg
+}
+
+// Format gives *JoinError the same %s/%v/%q/%+v behavior as *Error, and on
+// %+v also walks Children so each joined error's own stack trace is shown.
+func (e *JoinError) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('+') {
+			io.WriteString(s, e.joinVerboseMessage())
+			return
+		}
+		io.WriteString(s, e.base.message())
+	case 's':
+		io.WriteString(s, e.base.message())
+	case 'q':
+		fmt.Fprintf(s, "%q", e.base.message())
+	}
+}
+
+func (e *JoinError) joinVerboseMessage() string {
+	msg := e.base.verboseMessage()
+
+	for i, child := range e.children {
+		msg += fmt.Sprintf("\n-- joined error %d --\n%+v", i, child)
+	}
+
+	return msg
+}
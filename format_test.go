@@ -0,0 +1,88 @@
+package fail
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type formattingError struct {
+	msg string
+}
+
+func (e *formattingError) Error() string { return e.msg }
+
+func (e *formattingError) Format(s fmt.State, verb rune) {
+	if verb == 'v' && s.Flag('+') {
+		fmt.Fprintf(s, "formatted: %s", e.msg)
+		return
+	}
+	fmt.Fprint(s, e.msg)
+}
+
+func TestError_Format(t *testing.T) {
+	t.Run("%s and %q print FullMessage", func(t *testing.T) {
+		err := Wrap(errors.New("boom"), func(e *Error) { e.Messages = []string{"context"} })
+
+		if got := fmt.Sprintf("%s", err); got != "context" {
+			t.Fatalf("expected %%s to print FullMessage, got %q", got)
+		}
+		if got := fmt.Sprintf("%q", err); got != `"context"` {
+			t.Fatalf("expected %%q to print FullMessage quoted, got %q", got)
+		}
+	})
+
+	t.Run("%+v appends code, tags, params and the stack trace", func(t *testing.T) {
+		err := Wrap(errors.New("boom"), func(e *Error) {
+			e.Code = 404
+			e.Tags = []string{"client"}
+			e.Params = H{"id": 1}
+		})
+
+		got := fmt.Sprintf("%+v", err)
+		if !strings.Contains(got, "code=404") {
+			t.Fatalf("expected code=404 in %%+v output, got %q", got)
+		}
+		if !strings.Contains(got, "tags=[client]") {
+			t.Fatalf("expected tags=[client] in %%+v output, got %q", got)
+		}
+		if !strings.Contains(got, "params=map[id:1]") {
+			t.Fatalf("expected params in %%+v output, got %q", got)
+		}
+		if lines := strings.Split(got, "\n"); len(lines) <= 4 {
+			t.Fatalf("expected %%+v to include at least one stack frame line, got %q", got)
+		}
+	})
+
+	t.Run("%+v on Wrap(Base(...)) does not duplicate code, tags and params", func(t *testing.T) {
+		base := Base("not found", func(e *Error) {
+			e.Code = 404
+			e.Tags = []string{"client"}
+		})
+
+		got := fmt.Sprintf("%+v", Wrap(base))
+		if n := strings.Count(got, "code=404"); n != 1 {
+			t.Fatalf("expected code=404 to appear once, got %d times in %q", n, got)
+		}
+		if n := strings.Count(got, "tags=[client]"); n != 1 {
+			t.Fatalf("expected tags=[client] to appear once, got %d times in %q", n, got)
+		}
+	})
+
+	t.Run("%+v recurses into a cause that implements fmt.Formatter", func(t *testing.T) {
+		got := fmt.Sprintf("%+v", Wrap(&formattingError{"root cause"}))
+
+		if !strings.Contains(got, "formatted: root cause") {
+			t.Fatalf("expected the cause's own %%+v output to show up, got %q", got)
+		}
+	})
+
+	t.Run("JoinError's %+v renders each child's own trace", func(t *testing.T) {
+		got := fmt.Sprintf("%+v", Join(errors.New("a"), errors.New("b")))
+
+		if n := strings.Count(got, "-- joined error"); n != 2 {
+			t.Fatalf("expected both children to be rendered, got %d in %q", n, got)
+		}
+	})
+}
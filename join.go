@@ -0,0 +1,122 @@
+package fail
+
+import "strings"
+
+// JoinError is the result of Join. Code, Tags, Params, FullMessage, etc.
+// are reachable through base (a named field, not embedded, since an
+// embedded *Error would shadow the promoted Error() method); each joined
+// error is also kept as its own annotated *Error with its own StackTrace.
+type JoinError struct {
+	base     *Error
+	children []*Error
+}
+
+// Join returns an error that wraps each of the given errors, mirroring the
+// standard library's errors.Join. Nil entries are filtered out; if nothing
+// remains, Join returns nil. Unlike errors.Join, each surviving error is
+// captured as its own *fail.Error, so it keeps its own stack trace and can
+// still be annotated or logged individually via Children. A *JoinError
+// passed in as one of errs has its children absorbed directly instead of
+// being re-wrapped as a single opaque child.
+func Join(errs ...error) error {
+	var children []*Error
+	var messages []string
+
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+
+		if nested, ok := err.(*JoinError); ok {
+			children = append(children, nested.children...)
+			messages = append(messages, nested.base.Error())
+			continue
+		}
+
+		child := wrap(err)
+		children = append(children, child)
+		messages = append(messages, child.Error())
+	}
+
+	if len(children) == 0 {
+		return nil
+	}
+
+	return &JoinError{
+		base: &Error{
+			Err:        joinMessage{messages},
+			Messages:   []string{strings.Join(messages, "\n")},
+			StackTrace: newStackTrace(0),
+		},
+		children: children,
+	}
+}
+
+// joinMessage is the Err of a JoinError: a plain error whose text is the
+// children's messages joined with newlines, matching errors.Join.
+type joinMessage struct {
+	messages []string
+}
+
+func (m joinMessage) Error() string {
+	return strings.Join(m.messages, "\n")
+}
+
+// Error implements the error interface by forwarding to base.
+func (e *JoinError) Error() string {
+	return e.base.Error()
+}
+
+// Unwrap returns every child error so errors.Is and errors.As (Go 1.20+)
+// can traverse each branch of the join independently.
+func (e *JoinError) Unwrap() []error {
+	errs := make([]error, len(e.children))
+	for i, child := range e.children {
+		errs[i] = child
+	}
+	return errs
+}
+
+// Children returns the individual errors collected by Join, each with its
+// own stack trace and annotations intact.
+func (e *JoinError) Children() []*Error {
+	return e.children
+}
+
+// Code returns the Code attached to the join itself (e.g. via
+// Wrap(join, fail.WithCode(...))), not any of its children's.
+func (e *JoinError) Code() interface{} {
+	return e.base.Code
+}
+
+// Tags returns the Tags attached to the join itself, not any of its
+// children's.
+func (e *JoinError) Tags() []string {
+	return e.base.Tags
+}
+
+// Params returns the Params attached to the join itself, not any of its
+// children's.
+func (e *JoinError) Params() H {
+	return e.base.Params
+}
+
+// StackTrace returns the join's own stack trace, captured at the call site
+// of Join, as opposed to any child's. Wrapping the join keeps this trace
+// rather than replacing it with the Wrap call site.
+func (e *JoinError) StackTrace() StackTrace {
+	return e.base.StackTrace
+}
+
+// FullMessage returns the join-level message: each child's message,
+// concatenated with newlines, preceded by any messages added to the join
+// through Wrap (e.g. WithMessage).
+func (e *JoinError) FullMessage() string {
+	return e.base.FullMessage()
+}
+
+// Cause returns the join's own Err, matching (*Error).Cause for pkg/errors
+// interop.
+func (e *JoinError) Cause() error {
+	return e.base.Err
+}
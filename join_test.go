@@ -0,0 +1,65 @@
+package fail
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestJoin(t *testing.T) {
+	t.Run("nil entries are filtered and an all-nil Join returns nil", func(t *testing.T) {
+		if err := Join(nil, nil); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+	})
+
+	t.Run("captures a stack trace at the call site", func(t *testing.T) {
+		err := Join(errors.New("a"), errors.New("b"))
+
+		joinErr, ok := err.(*JoinError)
+		if !ok {
+			t.Fatalf("expected *JoinError, got %T", err)
+		}
+		if len(joinErr.StackTrace()) == 0 {
+			t.Fatal("expected Join to capture a stack trace")
+		}
+	})
+
+	t.Run("keeps each child as its own *Error with its own stack trace", func(t *testing.T) {
+		err := Join(errors.New("a"), errors.New("b"))
+
+		joinErr := err.(*JoinError)
+		children := joinErr.Children()
+		if len(children) != 2 {
+			t.Fatalf("expected 2 children, got %d", len(children))
+		}
+		for i, child := range children {
+			if len(child.StackTrace) == 0 {
+				t.Fatalf("child %d: expected its own stack trace", i)
+			}
+		}
+	})
+
+	t.Run("errors.Is traverses every child", func(t *testing.T) {
+		target := errors.New("needle")
+		err := Join(errors.New("a"), target)
+
+		if !errors.Is(err, target) {
+			t.Fatal("expected errors.Is to find target among the joined errors")
+		}
+	})
+
+	t.Run("nesting a JoinError absorbs its children instead of flattening them into one opaque error", func(t *testing.T) {
+		inner := Join(errors.New("a"), errors.New("b"))
+		outer := Join(inner, errors.New("c")).(*JoinError)
+
+		children := outer.Children()
+		if len(children) != 3 {
+			t.Fatalf("expected the inner join's 2 children plus 1 more, got %d", len(children))
+		}
+		for i, child := range children {
+			if len(child.StackTrace) == 0 {
+				t.Fatalf("child %d: expected its own stack trace to survive nesting", i)
+			}
+		}
+	})
+}
@@ -0,0 +1,104 @@
+package fail
+
+import "errors"
+
+const defaultParamsPrefix = "params."
+
+// MapOption configures ToMap.
+type MapOption func(*mapOptions)
+
+type mapOptions struct {
+	paramsPrefix string
+	onCollision  func(m map[string]interface{}, key string, value interface{})
+}
+
+// WithParamsPrefix overrides the "params." prefix ToMap uses when flattening
+// Params into the result map.
+func WithParamsPrefix(prefix string) MapOption {
+	return func(o *mapOptions) {
+		o.paramsPrefix = prefix
+	}
+}
+
+// WithCollisionPolicy controls what ToMap does when a prefixed param key
+// already exists in the result map (for example "code" clashing with a
+// param literally named "code"). The default policy overwrites silently.
+func WithCollisionPolicy(onCollision func(m map[string]interface{}, key string, value interface{})) MapOption {
+	return func(o *mapOptions) {
+		o.onCollision = onCollision
+	}
+}
+
+// ToMap flattens err into the shape structured loggers expect: the
+// underlying error message, the annotated FullMessage, Code, Tags,
+// Ignorable, the stack as []Frame (so callers can render it however their
+// logger wants instead of parsing a pre-formatted string), and each Params
+// entry under a "params."-prefixed key (customizable via
+// WithParamsPrefix). A *fail.Error wrapped further down the chain (e.g. by
+// fmt.Errorf("...: %w", failErr)) is still found; a *JoinError resolves to
+// its own Code/Tags/Params rather than one of its children's. It returns
+// nil if err is nil, and a best-effort single-entry map if no *fail.Error
+// is anywhere in the chain.
+func ToMap(err error, opts ...MapOption) map[string]interface{} {
+	if err == nil {
+		return nil
+	}
+
+	o := mapOptions{paramsPrefix: defaultParamsPrefix}
+	for _, f := range opts {
+		f(&o)
+	}
+
+	appErr, ok := findError(err)
+	if !ok {
+		return map[string]interface{}{"error": err.Error()}
+	}
+
+	m := map[string]interface{}{
+		"error":     errorMessage(appErr.Err),
+		"message":   appErr.FullMessage(),
+		"code":      appErr.Code,
+		"tags":      appErr.Tags,
+		"ignorable": appErr.Ignorable,
+		"stack":     appErr.StackFrames(),
+	}
+
+	for k, v := range appErr.Params {
+		key := o.paramsPrefix + k
+		if _, collides := m[key]; collides && o.onCollision != nil {
+			o.onCollision(m, key, v)
+			continue
+		}
+		m[key] = v
+	}
+
+	return m
+}
+
+// findError searches err's chain for a *fail.Error, resolving a
+// *JoinError to its own base rather than descending into Children() the
+// way errors.As's multi-error Unwrap() []error support would (which
+// matches whichever child happens to be first, silently dropping the
+// join's own Code/Tags/Params and the rest of its children).
+func findError(err error) (*Error, bool) {
+	for err != nil {
+		if appErr, ok := asError(err); ok {
+			return appErr, true
+		}
+		err = errors.Unwrap(err)
+	}
+	return nil, false
+}
+
+func errorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// StackFrames returns the error's stack trace as file/line/function
+// triples, so loggers can render it without parsing the %+v text form.
+func (e *Error) StackFrames() []Frame {
+	return append([]Frame(nil), e.StackTrace...)
+}
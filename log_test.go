@@ -0,0 +1,93 @@
+package fail
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestToMap(t *testing.T) {
+	t.Run("returns nil for nil err", func(t *testing.T) {
+		if m := ToMap(nil); m != nil {
+			t.Fatalf("expected nil, got %v", m)
+		}
+	})
+
+	t.Run("flattens code, tags, ignorable, stack and params", func(t *testing.T) {
+		err := Wrap(errors.New("boom"), func(e *Error) {
+			e.Code = 404
+			e.Tags = []string{"client"}
+			e.Ignorable = true
+			e.Params = H{"id": 1}
+		})
+
+		m := ToMap(err)
+		if m["code"] != 404 {
+			t.Fatalf("expected code 404, got %v", m["code"])
+		}
+		if m["ignorable"] != true {
+			t.Fatalf("expected ignorable true, got %v", m["ignorable"])
+		}
+		if m["params.id"] != 1 {
+			t.Fatalf("expected params.id 1, got %v", m["params.id"])
+		}
+		if _, ok := m["stack"].([]Frame); !ok {
+			t.Fatalf("expected stack to be []Frame, got %T", m["stack"])
+		}
+	})
+
+	t.Run("WithParamsPrefix overrides the default params. prefix", func(t *testing.T) {
+		err := Wrap(errors.New("boom"), func(e *Error) {
+			e.Params = H{"id": 1}
+		})
+
+		m := ToMap(err, WithParamsPrefix("ctx."))
+		if m["ctx.id"] != 1 {
+			t.Fatalf("expected ctx.id 1, got %v", m)
+		}
+	})
+
+	t.Run("WithCollisionPolicy is invoked instead of silently overwriting", func(t *testing.T) {
+		err := Wrap(errors.New("boom"), func(e *Error) {
+			e.Code = 404
+			e.Params = H{"code": "param-code"}
+		})
+
+		var collided bool
+		m := ToMap(err, WithParamsPrefix(""), WithCollisionPolicy(func(m map[string]interface{}, key string, value interface{}) {
+			collided = true
+		}))
+
+		if !collided {
+			t.Fatal("expected the collision policy to be invoked for the \"code\" key")
+		}
+		if m["code"] != 404 {
+			t.Fatalf("expected the collision policy to leave the existing code in place, got %v", m["code"])
+		}
+	})
+
+	t.Run("best-effort single-entry map when no *fail.Error is in the chain", func(t *testing.T) {
+		m := ToMap(errors.New("plain"))
+
+		if len(m) != 1 || m["error"] != "plain" {
+			t.Fatalf("expected a single error entry, got %v", m)
+		}
+	})
+
+	t.Run("resolves a JoinError to its own Code/Tags, not the first child's", func(t *testing.T) {
+		joined := Wrap(Join(errors.New("a"), errors.New("b")), func(e *Error) {
+			e.Code = 500
+			e.Tags = []string{"join"}
+		})
+
+		m := ToMap(joined)
+		if m["code"] != 500 {
+			t.Fatalf("expected the join's own code 500, got %v", m["code"])
+		}
+		if tags, ok := m["tags"].([]string); !ok || len(tags) != 1 || tags[0] != "join" {
+			t.Fatalf("expected the join's own tags, got %v", m["tags"])
+		}
+		if errMsg, _ := m["error"].(string); errMsg != "a\nb" {
+			t.Fatalf("expected the join's full message \"a\\nb\", got %q", errMsg)
+		}
+	})
+}
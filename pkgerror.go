@@ -0,0 +1,46 @@
+package fail
+
+import "strings"
+
+// pkgError is the result of extracting one layer of a pkg/errors-style
+// wrapper: the error it wraps, the message this layer itself added (if
+// any), and a stack trace attached at this layer (if any).
+type pkgError struct {
+	Err        error
+	Message    string
+	StackTrace StackTrace
+}
+
+// extractPkgError decomposes err one layer, recognizing the causer
+// convention pkg/errors (and compatible packages) use: Cause() error for
+// the underlying error, with Error() conventionally "message: cause".
+// A *fail.Error is returned as-is, since its own wrap chain is handled by
+// Copy() rather than by peeling off a Cause(). If err doesn't implement
+// causer, or Cause() is nil, it's returned unchanged with no message or
+// stack trace.
+func extractPkgError(err error) pkgError {
+	if _, ok := err.(*Error); ok {
+		return pkgError{Err: err}
+	}
+
+	causer, ok := err.(interface{ Cause() error })
+	if !ok {
+		return pkgError{Err: err}
+	}
+
+	cause := causer.Cause()
+	if cause == nil {
+		return pkgError{Err: err}
+	}
+
+	pe := pkgError{Err: cause}
+	if msg := strings.TrimSuffix(err.Error(), cause.Error()); msg != err.Error() {
+		pe.Message = strings.TrimSuffix(msg, messageDelimiter)
+	}
+
+	if tracer, ok := err.(interface{ StackTrace() StackTrace }); ok {
+		pe.StackTrace = tracer.StackTrace()
+	}
+
+	return pe
+}
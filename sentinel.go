@@ -0,0 +1,93 @@
+package fail
+
+import "errors"
+
+// Base returns a sentinel error intended to be declared at package scope,
+// e.g.:
+//
+//	var ErrNotFound = fail.Base("not found", fail.WithCode(404))
+//
+// Unlike New and Errorf, Base does not capture a stack trace, so it stays
+// cheap and its location stays stable regardless of where it's declared.
+// Code, Tags and Params attached here are inherited by every error Wrap
+// produces from it, and errors.Is(err, ErrNotFound) keeps matching anywhere
+// along the wrap chain.
+func Base(text string, annotators ...Annotator) *Error {
+	e := &Error{
+		Err: errors.New(text),
+	}
+
+	for _, f := range annotators {
+		f(e)
+	}
+
+	e.isBase = true
+
+	return e
+}
+
+// asError extracts the *Error carried by err itself (including a
+// *JoinError's base), without walking further down the chain.
+func asError(err error) (*Error, bool) {
+	switch v := err.(type) {
+	case *Error:
+		return v, true
+	case *JoinError:
+		return v.base, true
+	default:
+		return nil, false
+	}
+}
+
+// walkErrors visits every *fail.Error reachable from err, stopping as soon
+// as visit returns true. A *JoinError only implements the multi-error
+// Unwrap() []error, so a plain err = errors.Unwrap(err) loop never reaches
+// its children; this descends into Children() explicitly instead.
+func walkErrors(err error, visit func(*Error) bool) bool {
+	for err != nil {
+		if appErr, ok := asError(err); ok && visit(appErr) {
+			return true
+		}
+
+		if joinErr, ok := err.(*JoinError); ok {
+			for _, child := range joinErr.children {
+				if walkErrors(child, visit) {
+					return true
+				}
+			}
+			return false
+		}
+
+		err = errors.Unwrap(err)
+	}
+
+	return false
+}
+
+// Code walks err's chain and returns the first non-nil Code it finds, or
+// nil if none of the errors in the chain are a *fail.Error with a Code set.
+func Code(err error) interface{} {
+	var code interface{}
+	walkErrors(err, func(appErr *Error) bool {
+		if appErr.Code == nil {
+			return false
+		}
+		code = appErr.Code
+		return true
+	})
+	return code
+}
+
+// Tags walks err's chain and returns the first non-empty Tags it finds, or
+// nil if none of the errors in the chain are a *fail.Error with Tags set.
+func Tags(err error) []string {
+	var tags []string
+	walkErrors(err, func(appErr *Error) bool {
+		if len(appErr.Tags) == 0 {
+			return false
+		}
+		tags = appErr.Tags
+		return true
+	})
+	return tags
+}
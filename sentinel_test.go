@@ -0,0 +1,68 @@
+package fail
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBase_Wrap(t *testing.T) {
+	t.Run("Wrap inherits Code, Tags, Ignorable and Params", func(t *testing.T) {
+		base := Base("bad request", func(e *Error) {
+			e.Code = 400
+			e.Ignorable = true
+			e.Tags = []string{"client"}
+			e.Params = H{"field": "name"}
+		})
+
+		wrapped := Extract(Wrap(base))
+
+		if wrapped.Code != 400 {
+			t.Fatalf("expected Code 400, got %v", wrapped.Code)
+		}
+		if !wrapped.Ignorable {
+			t.Fatal("expected Ignorable to be carried through from the base")
+		}
+		if len(wrapped.Tags) != 1 || wrapped.Tags[0] != "client" {
+			t.Fatalf("expected Tags to be carried through, got %v", wrapped.Tags)
+		}
+		if wrapped.Params["field"] != "name" {
+			t.Fatalf("expected Params to be carried through, got %v", wrapped.Params)
+		}
+	})
+
+	t.Run("Wrap does not mutate the base's Params when the copy is mutated", func(t *testing.T) {
+		base := Base("bad request", func(e *Error) {
+			e.Params = H{"field": "name"}
+		})
+
+		wrapped := Extract(Wrap(base))
+		wrapped.Params["field"] = "mutated"
+
+		if base.Params["field"] != "name" {
+			t.Fatalf("expected base.Params to be untouched, got %v", base.Params["field"])
+		}
+	})
+
+	t.Run("errors.Is matches the base sentinel through Wrap", func(t *testing.T) {
+		base := Base("not found", func(e *Error) { e.Code = 404 })
+		wrapped := Wrap(base)
+
+		if !errors.Is(wrapped, base) {
+			t.Fatal("expected errors.Is to match the base sentinel through Wrap")
+		}
+	})
+}
+
+func TestCodeTags_JoinError(t *testing.T) {
+	joined := Wrap(Join(errors.New("a"), errors.New("b")), func(e *Error) {
+		e.Code = 500
+		e.Tags = []string{"join"}
+	})
+
+	if Code(joined) != 500 {
+		t.Fatalf("expected Code(joined) to be 500, got %v", Code(joined))
+	}
+	if tags := Tags(joined); len(tags) != 1 || tags[0] != "join" {
+		t.Fatalf("expected Tags(joined) to be [join], got %v", tags)
+	}
+}
@@ -0,0 +1,49 @@
+package fail
+
+import "runtime"
+
+// Frame is one entry of a StackTrace: the file, line and function at a
+// single level of the call stack.
+type Frame struct {
+	File     string
+	Line     int
+	Function string
+}
+
+// StackTrace is an ordered list of Frame, innermost call first.
+type StackTrace []Frame
+
+// maxStackDepth bounds how many frames newStackTrace captures.
+const maxStackDepth = 32
+
+// newStackTrace captures the current goroutine's call stack, skipping the
+// given number of frames above its own caller.
+func newStackTrace(skip int) StackTrace {
+	var pcs [maxStackDepth]uintptr
+	n := runtime.Callers(skip+2, pcs[:])
+	if n == 0 {
+		return nil
+	}
+
+	frames := runtime.CallersFrames(pcs[:n])
+	trace := make(StackTrace, 0, n)
+	for {
+		frame, more := frames.Next()
+		trace = append(trace, Frame{File: frame.File, Line: frame.Line, Function: frame.Function})
+		if !more {
+			break
+		}
+	}
+
+	return trace
+}
+
+// mergeStackTraces prefers an already-captured trace, which points closer
+// to where the error actually originated, over one captured at a later
+// Wrap call site.
+func mergeStackTraces(existing, additional StackTrace) StackTrace {
+	if len(existing) > 0 {
+		return existing
+	}
+	return additional
+}